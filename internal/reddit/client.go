@@ -19,12 +19,29 @@ const (
 )
 
 type Client struct {
-	id     string
-	secret string
-	client *http.Client
-	tracer *httptrace.ClientTrace
-	parser *fastjson.Parser
-	statsd *statsd.Client
+	id          string
+	secret      string
+	pool        *ClientPool
+	tracer      *httptrace.ClientTrace
+	parserPool  *fastjson.ParserPool
+	statsd      *statsd.Client
+	useFastjson bool
+}
+
+// ClientOption configures optional behavior on a Client at construction
+// time.
+type ClientOption func(*Client)
+
+// WithFastjsonListings sets the client-wide default for decoding Listing
+// responses (subreddit and user listings) to the fastjson-backed
+// parseListing path instead of a full encoding/json unmarshal, trading the
+// unused fields on the typed response structs for lower allocation overhead
+// on hot polling paths. Individual calls can still override this default
+// per endpoint with a ListingOption (WithFastjsonDecoding/WithJSONDecoding).
+func WithFastjsonListings() ClientOption {
+	return func(c *Client) {
+		c.useFastjson = true
+	}
 }
 
 func SplitID(id string) (string, string) {
@@ -50,7 +67,7 @@ func PostIDFromContext(context string) string {
 	return ""
 }
 
-func NewClient(id, secret string, statsd *statsd.Client) *Client {
+func NewClient(id, secret string, statsd *statsd.Client, opts ...ClientOption) *Client {
 	tracer := &httptrace.ClientTrace{
 		GotConn: func(info httptrace.GotConnInfo) {
 			if info.Reused {
@@ -65,68 +82,118 @@ func NewClient(id, secret string, statsd *statsd.Client) *Client {
 		},
 	}
 
-	client := &http.Client{}
+	pool := NewClientPool()
 
-	parser := &fastjson.Parser{}
+	parserPool := &fastjson.ParserPool{}
 
-	return &Client{
+	c := &Client{
 		id,
 		secret,
-		client,
+		pool,
 		tracer,
-		parser,
+		parserPool,
 		statsd,
+		false,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
 type AuthenticatedClient struct {
 	*Client
 
+	accountID    string
 	refreshToken string
 	accessToken  string
 	expiry       *time.Time
+	account      *accountState
+}
+
+// NewAuthenticatedClient builds a client for making authenticated requests
+// as a single Reddit account. accountID must be a stable identifier for the
+// account (e.g. its fullname or database id) rather than refreshToken
+// itself, since Reddit may rotate refresh tokens on use: the ClientPool's
+// per-account concurrency limit and rate-limit bucket are keyed on accountID
+// so they stay in effect across tokens and across AuthenticatedClients
+// recreated for the same account on a later poll.
+func (rc *Client) NewAuthenticatedClient(accountID, refreshToken, accessToken string) *AuthenticatedClient {
+	return &AuthenticatedClient{rc, accountID, refreshToken, accessToken, nil, rc.pool.stateFor(accountID)}
 }
 
-func (rc *Client) NewAuthenticatedClient(refreshToken, accessToken string) *AuthenticatedClient {
-	return &AuthenticatedClient{rc, refreshToken, accessToken, nil}
+// RateLimitState returns the quota Reddit reported on the account's last
+// response, so callers can decide whether to defer non-essential polling.
+// Before the account's first response, Remaining is rateLimitThreshold and
+// Used and Reset are zero.
+func (rac *AuthenticatedClient) RateLimitState() RateLimitState {
+	return rac.account.limiter.state()
 }
 
 func (rac *AuthenticatedClient) request(r *Request) ([]byte, error) {
-	req, err := r.HTTPRequest()
-	if err != nil {
-		return nil, err
-	}
+	rac.account.limiter.wait()
 
-	req = req.WithContext(httptrace.WithClientTrace(req.Context(), rac.tracer))
+	for attempt := 0; ; attempt++ {
+		req, err := r.HTTPRequest()
+		if err != nil {
+			return nil, err
+		}
 
-	start := time.Now()
-	resp, err := rac.client.Do(req)
-	rac.statsd.Incr("reddit.api.calls", r.tags, 0.1)
-	rac.statsd.Histogram("reddit.api.latency", float64(time.Now().Sub(start).Milliseconds()), r.tags, 0.1)
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), rac.tracer))
 
-	if err != nil {
-		rac.statsd.Incr("reddit.api.errors", r.tags, 0.1)
-		return nil, err
-	}
-	defer resp.Body.Close()
+		rac.account.semaphore.acquire()
+		rac.pool.global.acquire()
 
-	bb, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		rac.statsd.Incr("reddit.api.errors", r.tags, 0.1)
-		return nil, err
-	}
+		start := time.Now()
+		resp, err := rac.pool.client.Do(req)
+		rac.account.semaphore.release()
+		rac.pool.global.release()
+		rac.statsd.Incr("reddit.api.calls", r.tags, 0.1)
+		rac.statsd.Histogram("reddit.api.latency", float64(time.Now().Sub(start).Milliseconds()), r.tags, 0.1)
+
+		if err != nil {
+			rac.statsd.Incr("reddit.api.errors", r.tags, 0.1)
+			return nil, err
+		}
+
+		rac.account.limiter.update(resp.Header)
+		state := rac.account.limiter.state()
+		rac.statsd.Gauge("reddit.api.ratelimit.remaining", state.Remaining, r.tags, 0.1)
+		rac.statsd.Gauge("reddit.api.ratelimit.reset", state.Reset.Seconds(), r.tags, 0.1)
 
-	if resp.StatusCode != 200 {
-		rac.statsd.Incr("reddit.api.errors", r.tags, 0.1)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			resp.Body.Close()
+			rac.statsd.Incr("reddit.api.ratelimit.throttled", r.tags, 0.1)
+
+			if attempt >= rateLimitMaxRetries {
+				return nil, fmt.Errorf("error from reddit: %d", resp.StatusCode)
+			}
 
-		// Try to parse a json error. Otherwise we generate a generic one
-		rerr := &Error{}
-		if jerr := json.Unmarshal(bb, rerr); jerr != nil {
-			return nil, fmt.Errorf("error from reddit: %d", resp.StatusCode)
+			time.Sleep(backoff(resp.Header, attempt))
+			continue
+		}
+
+		bb, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			rac.statsd.Incr("reddit.api.errors", r.tags, 0.1)
+			return nil, err
+		}
+
+		if resp.StatusCode != 200 {
+			rac.statsd.Incr("reddit.api.errors", r.tags, 0.1)
+
+			// Try to parse a json error. Otherwise we generate a generic one
+			rerr := &Error{}
+			if jerr := json.Unmarshal(bb, rerr); jerr != nil {
+				return nil, fmt.Errorf("error from reddit: %d", resp.StatusCode)
+			}
+			return nil, rerr
 		}
-		return nil, rerr
+		return bb, nil
 	}
-	return bb, nil
 }
 
 func (rac *AuthenticatedClient) RefreshTokens() (*RefreshTokenResponse, error) {
@@ -150,6 +217,9 @@ func (rac *AuthenticatedClient) RefreshTokens() (*RefreshTokenResponse, error) {
 	return rtr, nil
 }
 
+// MessageInbox always decodes with encoding/json: it takes no ListingOption
+// and ignores WithFastjsonListings. The fastjson path is used only by the
+// listing endpoints SubredditNew/Hot and UserComments/Submissions.
 func (rac *AuthenticatedClient) MessageInbox(from string) (*MessageListingResponse, error) {
 	req := NewRequest(
 		WithTags([]string{"url:/api/v1/message/inbox"}),
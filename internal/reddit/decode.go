@@ -0,0 +1,133 @@
+package reddit
+
+// ListingOption overrides, for a single listing call, whether the response
+// is decoded with parseListing or a full encoding/json unmarshal. It takes
+// precedence over the client-wide default set by WithFastjsonListings,
+// letting callers opt in (or out) per endpoint.
+type ListingOption func(*listingConfig)
+
+type listingConfig struct {
+	fastjson *bool
+}
+
+// WithFastjsonDecoding decodes this listing call with parseListing,
+// regardless of the client's default.
+func WithFastjsonDecoding() ListingOption {
+	return func(c *listingConfig) {
+		enabled := true
+		c.fastjson = &enabled
+	}
+}
+
+// WithJSONDecoding decodes this listing call with a full encoding/json
+// unmarshal, regardless of the client's default.
+func WithJSONDecoding() ListingOption {
+	return func(c *listingConfig) {
+		enabled := false
+		c.fastjson = &enabled
+	}
+}
+
+func (rac *AuthenticatedClient) useFastjsonFor(opts []ListingOption) bool {
+	cfg := &listingConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if cfg.fastjson != nil {
+		return *cfg.fastjson
+	}
+	return rac.useFastjson
+}
+
+// ListingItem is the minimal set of fields the notification workers read off
+// a Reddit Listing entry. It's materialized straight from the raw JSON via
+// parseListing instead of a full encoding/json unmarshal into a typed
+// response struct, for hot polling paths where the rest of the payload is
+// never read.
+type ListingItem struct {
+	ID         string
+	Author     string
+	Body       string
+	CreatedUTC float64
+	Permalink  string
+	Subreddit  string
+}
+
+// parseListing walks a Reddit Listing's data.children[*].data and
+// materializes only the fields ListingItem needs, skipping the allocation
+// overhead of unmarshalling every field of every child into a typed struct.
+// It also returns data.after, the cursor callers pass as "before" on their
+// next poll, so the fastjson path paginates exactly like the encoding/json
+// one. A fastjson.Parser isn't safe for concurrent use, so this borrows one
+// from the client's pool for the duration of the call rather than sharing a
+// single parser across the many accounts a ClientPool drives concurrently.
+func (rc *Client) parseListing(bb []byte) ([]ListingItem, string, error) {
+	p := rc.parserPool.Get()
+	defer rc.parserPool.Put(p)
+
+	v, err := p.ParseBytes(bb)
+	if err != nil {
+		return nil, "", err
+	}
+
+	after := string(v.GetStringBytes("data", "after"))
+
+	children := v.GetArray("data", "children")
+	items := make([]ListingItem, 0, len(children))
+	for _, child := range children {
+		data := child.Get("data")
+		if data == nil {
+			continue
+		}
+
+		items = append(items, ListingItem{
+			ID:         string(data.GetStringBytes("id")),
+			Author:     string(data.GetStringBytes("author")),
+			Body:       string(data.GetStringBytes("body")),
+			CreatedUTC: data.GetFloat64("created_utc"),
+			Permalink:  string(data.GetStringBytes("permalink")),
+			Subreddit:  string(data.GetStringBytes("subreddit")),
+		})
+	}
+	return items, after, nil
+}
+
+func submissionListingFromItems(items []ListingItem, after string) *SubmissionListingResponse {
+	slr := &SubmissionListingResponse{}
+	slr.Data.After = after
+	for _, item := range items {
+		var child struct {
+			Data Submission `json:"data"`
+		}
+		child.Data = Submission{
+			ID:         item.ID,
+			Author:     item.Author,
+			Permalink:  item.Permalink,
+			Subreddit:  item.Subreddit,
+			CreatedUTC: item.CreatedUTC,
+		}
+		slr.Data.Children = append(slr.Data.Children, child)
+	}
+	return slr
+}
+
+func commentListingFromItems(items []ListingItem, after string) *CommentListingResponse {
+	clr := &CommentListingResponse{}
+	clr.Data.After = after
+	for _, item := range items {
+		var child struct {
+			Data Comment `json:"data"`
+		}
+		child.Data = Comment{
+			ID:         item.ID,
+			Author:     item.Author,
+			Body:       item.Body,
+			Permalink:  item.Permalink,
+			Subreddit:  item.Subreddit,
+			CreatedUTC: item.CreatedUTC,
+		}
+		clr.Data.Children = append(clr.Data.Children, child)
+	}
+	return clr
+}
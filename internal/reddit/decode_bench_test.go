@@ -0,0 +1,41 @@
+package reddit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/valyala/fastjson"
+)
+
+var benchListingJSON = []byte(`{
+	"data": {
+		"after": "t3_abc123",
+		"children": [
+			{
+				"kind": "t1",
+				"data": {
+					"id": "abc123",
+					"author": "someuser",
+					"body": "hello world",
+					"created_utc": 1700000000,
+					"permalink": "/r/test/comments/abc123/_/",
+					"subreddit": "test"
+				}
+			}
+		]
+	}
+}`)
+
+func BenchmarkParseListingJSON(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		clr := &CommentListingResponse{}
+		json.Unmarshal(benchListingJSON, clr)
+	}
+}
+
+func BenchmarkParseListingFastjson(b *testing.B) {
+	rc := &Client{parserPool: &fastjson.ParserPool{}}
+	for i := 0; i < b.N; i++ {
+		rc.parseListing(benchListingJSON)
+	}
+}
@@ -0,0 +1,284 @@
+package reddit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Submission is a single post as returned by the subreddit and user listing
+// endpoints.
+type Submission struct {
+	ID         string  `json:"id"`
+	Author     string  `json:"author"`
+	Title      string  `json:"title"`
+	Permalink  string  `json:"permalink"`
+	Subreddit  string  `json:"subreddit"`
+	CreatedUTC float64 `json:"created_utc"`
+}
+
+// Comment is a single comment as returned by the user comments and post
+// comments endpoints. Replies is only populated by PostComments, which walks
+// the full reply tree; the flat user/subreddit listings never nest.
+type Comment struct {
+	ID         string    `json:"id"`
+	Author     string    `json:"author"`
+	Body       string    `json:"body"`
+	Permalink  string    `json:"permalink"`
+	Subreddit  string    `json:"subreddit"`
+	LinkID     string    `json:"link_id"`
+	CreatedUTC float64   `json:"created_utc"`
+	Replies    []Comment `json:"-"`
+}
+
+// SubmissionListingResponse wraps a Reddit Listing of submissions, as
+// returned by the subreddit and user submitted endpoints.
+type SubmissionListingResponse struct {
+	Data struct {
+		After    string `json:"after"`
+		Children []struct {
+			Data Submission `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// CommentListingResponse wraps a Reddit Listing of comments, as returned by
+// the user comments endpoint.
+type CommentListingResponse struct {
+	Data struct {
+		After    string `json:"after"`
+		Children []struct {
+			Data Comment `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// PostCommentsResponse is the post plus its comment tree, as returned by
+// Reddit's /comments/{id} endpoint, which responds with a two-element array
+// of Listings rather than a single object.
+type PostCommentsResponse struct {
+	Post     Submission
+	Comments []Comment
+}
+
+func (pcr *PostCommentsResponse) UnmarshalJSON(bb []byte) error {
+	var listings []struct {
+		Data struct {
+			Children []json.RawMessage `json:"children"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal(bb, &listings); err != nil {
+		return err
+	}
+
+	if len(listings) > 0 && len(listings[0].Data.Children) > 0 {
+		var thing struct {
+			Data Submission `json:"data"`
+		}
+		json.Unmarshal(listings[0].Data.Children[0], &thing)
+		pcr.Post = thing.Data
+	}
+
+	if len(listings) > 1 {
+		pcr.Comments = parseCommentChildren(listings[1].Data.Children)
+	}
+
+	return nil
+}
+
+// parseCommentChildren decodes a Listing's "children" into Comments,
+// recursing into each comment's "replies" (itself a nested Listing, or the
+// empty string when there are none) and skipping non-"t1" things such as
+// the "more" stubs Reddit uses to mark collapsed/truncated reply threads.
+func parseCommentChildren(children []json.RawMessage) []Comment {
+	var comments []Comment
+
+	for _, child := range children {
+		var thing struct {
+			Kind string          `json:"kind"`
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(child, &thing); err != nil || thing.Kind != "t1" {
+			continue
+		}
+
+		var body struct {
+			Comment
+			Replies json.RawMessage `json:"replies"`
+		}
+		if err := json.Unmarshal(thing.Data, &body); err != nil {
+			continue
+		}
+
+		comment := body.Comment
+
+		var replies struct {
+			Data struct {
+				Children []json.RawMessage `json:"children"`
+			} `json:"data"`
+		}
+		if json.Unmarshal(body.Replies, &replies) == nil {
+			comment.Replies = parseCommentChildren(replies.Data.Children)
+		}
+
+		comments = append(comments, comment)
+	}
+
+	return comments
+}
+
+// SubredditResponse is subreddit metadata, as returned by the /r/{name}/about
+// endpoint.
+type SubredditResponse struct {
+	Data struct {
+		DisplayName string `json:"display_name"`
+		Title       string `json:"title"`
+		Subscribers int    `json:"subscribers"`
+		Over18      bool   `json:"over18"`
+	} `json:"data"`
+}
+
+func (rac *AuthenticatedClient) SubredditNew(name, before string, opts ...ListingOption) (*SubmissionListingResponse, error) {
+	req := NewRequest(
+		WithTags([]string{"url:/r/subreddit/new"}),
+		WithMethod("GET"),
+		WithToken(rac.accessToken),
+		WithURL(fmt.Sprintf("https://oauth.reddit.com/r/%s/new.json", name)),
+		WithQuery("before", before),
+	)
+
+	body, err := rac.request(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rac.useFastjsonFor(opts) {
+		items, after, err := rac.parseListing(body)
+		if err != nil {
+			return nil, err
+		}
+		return submissionListingFromItems(items, after), nil
+	}
+
+	slr := &SubmissionListingResponse{}
+	json.Unmarshal(body, slr)
+	return slr, nil
+}
+
+func (rac *AuthenticatedClient) SubredditHot(name string, opts ...ListingOption) (*SubmissionListingResponse, error) {
+	req := NewRequest(
+		WithTags([]string{"url:/r/subreddit/hot"}),
+		WithMethod("GET"),
+		WithToken(rac.accessToken),
+		WithURL(fmt.Sprintf("https://oauth.reddit.com/r/%s/hot.json", name)),
+	)
+
+	body, err := rac.request(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rac.useFastjsonFor(opts) {
+		items, after, err := rac.parseListing(body)
+		if err != nil {
+			return nil, err
+		}
+		return submissionListingFromItems(items, after), nil
+	}
+
+	slr := &SubmissionListingResponse{}
+	json.Unmarshal(body, slr)
+	return slr, nil
+}
+
+func (rac *AuthenticatedClient) UserComments(username, before string, opts ...ListingOption) (*CommentListingResponse, error) {
+	req := NewRequest(
+		WithTags([]string{"url:/user/username/comments"}),
+		WithMethod("GET"),
+		WithToken(rac.accessToken),
+		WithURL(fmt.Sprintf("https://oauth.reddit.com/user/%s/comments.json", username)),
+		WithQuery("before", before),
+	)
+
+	body, err := rac.request(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rac.useFastjsonFor(opts) {
+		items, after, err := rac.parseListing(body)
+		if err != nil {
+			return nil, err
+		}
+		return commentListingFromItems(items, after), nil
+	}
+
+	clr := &CommentListingResponse{}
+	json.Unmarshal(body, clr)
+	return clr, nil
+}
+
+func (rac *AuthenticatedClient) UserSubmissions(username, before string, opts ...ListingOption) (*SubmissionListingResponse, error) {
+	req := NewRequest(
+		WithTags([]string{"url:/user/username/submitted"}),
+		WithMethod("GET"),
+		WithToken(rac.accessToken),
+		WithURL(fmt.Sprintf("https://oauth.reddit.com/user/%s/submitted.json", username)),
+		WithQuery("before", before),
+	)
+
+	body, err := rac.request(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rac.useFastjsonFor(opts) {
+		items, after, err := rac.parseListing(body)
+		if err != nil {
+			return nil, err
+		}
+		return submissionListingFromItems(items, after), nil
+	}
+
+	slr := &SubmissionListingResponse{}
+	json.Unmarshal(body, slr)
+	return slr, nil
+}
+
+// PostComments fetches a post and its comment tree. postID is the id
+// extracted by PostIDFromContext.
+func (rac *AuthenticatedClient) PostComments(postID string) (*PostCommentsResponse, error) {
+	req := NewRequest(
+		WithTags([]string{"url:/comments/id"}),
+		WithMethod("GET"),
+		WithToken(rac.accessToken),
+		WithURL(fmt.Sprintf("https://oauth.reddit.com/comments/%s.json", postID)),
+	)
+
+	body, err := rac.request(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pcr := &PostCommentsResponse{}
+	json.Unmarshal(body, pcr)
+	return pcr, nil
+}
+
+func (rac *AuthenticatedClient) Subreddit(name string) (*SubredditResponse, error) {
+	req := NewRequest(
+		WithTags([]string{"url:/r/subreddit/about"}),
+		WithMethod("GET"),
+		WithToken(rac.accessToken),
+		WithURL(fmt.Sprintf("https://oauth.reddit.com/r/%s/about.json", name)),
+	)
+
+	body, err := rac.request(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sr := &SubredditResponse{}
+	json.Unmarshal(body, sr)
+	return sr, nil
+}
@@ -0,0 +1,54 @@
+package reddit
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+const (
+	authorizeURL = "https://www.reddit.com/api/v1/authorize"
+)
+
+// NewAuthorizationURL builds the URL a user should be redirected to in order
+// to grant Apollo's backend access to their account via the OAuth2
+// authorization-code flow. duration should be either "temporary" or
+// "permanent", matching Reddit's spec.
+func (rc *Client) NewAuthorizationURL(redirectURI, state, duration string, scopes ...string) string {
+	v := url.Values{}
+	v.Set("client_id", rc.id)
+	v.Set("response_type", "code")
+	v.Set("redirect_uri", redirectURI)
+	v.Set("state", state)
+	v.Set("duration", duration)
+	v.Set("scope", strings.Join(scopes, " "))
+
+	return authorizeURL + "?" + v.Encode()
+}
+
+// ExchangeCode trades an authorization code obtained from the
+// NewAuthorizationURL redirect for a fresh set of tokens, onboarding a new
+// account without requiring a refresh token to already exist.
+func (rc *Client) ExchangeCode(code, redirectURI string) (*RefreshTokenResponse, error) {
+	req := NewRequest(
+		WithTags([]string{"url:/api/v1/access_token"}),
+		WithMethod("POST"),
+		WithURL(tokenURL),
+		WithBody("grant_type", "authorization_code"),
+		WithBody("code", code),
+		WithBody("redirect_uri", redirectURI),
+		WithBasicAuth(rc.id, rc.secret),
+	)
+
+	// ExchangeCode onboards an account that has no stable id yet, so it
+	// shares a single bootstrap slot in the pool rather than its own.
+	rac := rc.NewAuthenticatedClient("oauth-exchange", "", "")
+	body, err := rac.request(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rtr := &RefreshTokenResponse{}
+	json.Unmarshal(body, rtr)
+	return rtr, nil
+}
@@ -0,0 +1,96 @@
+package reddit
+
+import (
+	"net/http"
+	"sync"
+)
+
+const (
+	// defaultMaxConcurrency bounds how many requests can be in flight across
+	// the whole pool at once, regardless of how many accounts are being
+	// polled.
+	defaultMaxConcurrency = 32
+
+	// defaultMaxConcurrencyPerAccount bounds how many requests a single
+	// account can have in flight at once, so one noisy watcher can't starve
+	// the rest of the pool.
+	defaultMaxConcurrencyPerAccount = 2
+
+	defaultMaxIdleConnsPerHost = 64
+)
+
+// ClientPool owns the shared, HTTP/2-enabled transport and the per-account
+// state that every AuthenticatedClient's request() is routed through, so
+// requests from many accounts share connections instead of each
+// AuthenticatedClient reusing the same default http.Client with unbounded
+// parallelism.
+type ClientPool struct {
+	client *http.Client
+
+	global *semaphore
+
+	mu       sync.Mutex
+	accounts map[string]*accountState
+}
+
+// accountState is the long-lived, per-account state that must survive
+// across AuthenticatedClients recreated for the same account on a later
+// poll: its concurrency limiter and its learned rate-limit bucket.
+type accountState struct {
+	semaphore *semaphore
+	limiter   *rateLimiter
+}
+
+func newAccountState() *accountState {
+	return &accountState{
+		semaphore: newSemaphore(defaultMaxConcurrencyPerAccount),
+		limiter:   newRateLimiter(),
+	}
+}
+
+func NewClientPool() *ClientPool {
+	transport := &http.Transport{
+		MaxIdleConnsPerHost: defaultMaxIdleConnsPerHost,
+		ForceAttemptHTTP2:   true,
+	}
+
+	return &ClientPool{
+		client:   &http.Client{Transport: transport},
+		global:   newSemaphore(defaultMaxConcurrency),
+		accounts: make(map[string]*accountState),
+	}
+}
+
+// stateFor returns the account's long-lived state, creating it on first
+// use. accountID must be a stable identifier for the account, not a refresh
+// token: Reddit may rotate refresh tokens on use, and keying on the token
+// would both grow this map unboundedly and throw away the account's learned
+// rate-limit state on every rotation instead of persisting it.
+func (cp *ClientPool) stateFor(accountID string) *accountState {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	state, ok := cp.accounts[accountID]
+	if !ok {
+		state = newAccountState()
+		cp.accounts[accountID] = state
+	}
+	return state
+}
+
+// semaphore is a tiny buffered-channel concurrency limiter.
+type semaphore struct {
+	tokens chan struct{}
+}
+
+func newSemaphore(n int) *semaphore {
+	return &semaphore{tokens: make(chan struct{}, n)}
+}
+
+func (s *semaphore) acquire() {
+	s.tokens <- struct{}{}
+}
+
+func (s *semaphore) release() {
+	<-s.tokens
+}
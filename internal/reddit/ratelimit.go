@@ -0,0 +1,100 @@
+package reddit
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitThreshold is the quota, in remaining requests, at or below
+	// which we start deferring calls until Reddit's window resets.
+	rateLimitThreshold = 5
+
+	// rateLimitMaxRetries caps how many times a single request will retry a
+	// 429 before giving up.
+	rateLimitMaxRetries = 5
+)
+
+// RateLimitState is a snapshot of the quota Reddit reported for an account on
+// its last response.
+type RateLimitState struct {
+	Remaining float64
+	Used      float64
+	Reset     time.Duration
+}
+
+// rateLimiter tracks Reddit's per-account token bucket from the
+// X-Ratelimit-* headers and makes request() block rather than fire blindly
+// once the bucket runs low.
+type rateLimiter struct {
+	mu        sync.Mutex
+	remaining float64
+	used      float64
+	reset     time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{remaining: rateLimitThreshold}
+}
+
+func (rl *rateLimiter) state() RateLimitState {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	reset := time.Until(rl.reset)
+	if reset < 0 {
+		reset = 0
+	}
+
+	return RateLimitState{rl.remaining, rl.used, reset}
+}
+
+func (rl *rateLimiter) update(header http.Header) {
+	remaining, err := strconv.ParseFloat(header.Get("X-Ratelimit-Remaining"), 64)
+	if err != nil {
+		return
+	}
+	used, _ := strconv.ParseFloat(header.Get("X-Ratelimit-Used"), 64)
+	resetSecs, _ := strconv.ParseFloat(header.Get("X-Ratelimit-Reset"), 64)
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.remaining = remaining
+	rl.used = used
+	rl.reset = time.Now().Add(time.Duration(resetSecs) * time.Second)
+}
+
+// wait blocks until the bucket has had a chance to recover if the last
+// response left us at or below rateLimitThreshold, so a burst of watchers
+// refreshing at once defers instead of tripping a 429 cascade.
+func (rl *rateLimiter) wait() {
+	rl.mu.Lock()
+	remaining := rl.remaining
+	reset := rl.reset
+	rl.mu.Unlock()
+
+	if remaining > rateLimitThreshold {
+		return
+	}
+
+	if d := time.Until(reset); d > 0 {
+		time.Sleep(d)
+	}
+}
+
+// backoff returns how long to sleep before retrying a 429, honoring Reddit's
+// Retry-After header when present and jittering the rest so a pile of
+// watchers hitting the limit together don't all retry in lockstep.
+func backoff(header http.Header, attempt int) time.Duration {
+	base := time.Duration(1<<uint(attempt)) * time.Second
+	if ra, err := strconv.Atoi(header.Get("Retry-After")); err == nil {
+		base = time.Duration(ra) * time.Second
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(base)/2 + 1))
+	return base + jitter
+}